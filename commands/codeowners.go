@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var codeownersLocations = []string{
+	"CODEOWNERS",
+	".github/CODEOWNERS",
+	"docs/CODEOWNERS",
+}
+
+// codeownersReviewers returns the handles listed as owners of "*" in the
+// repository's CODEOWNERS file, if any. It's a best-effort fallback for
+// auto-populating reviewers when GitHub's branch protection requires code
+// owner review but none were given explicitly; it doesn't attempt to match
+// the changed files against more specific CODEOWNERS patterns.
+func codeownersReviewers(workdir string) []string {
+	for _, location := range codeownersLocations {
+		file, err := os.Open(filepath.Join(workdir, location))
+		if err != nil {
+			continue
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			fields := strings.Fields(line)
+			if len(fields) < 2 || fields[0] != "*" {
+				continue
+			}
+
+			owners := []string{}
+			for _, owner := range fields[1:] {
+				// Keep the "org/team" form intact for teams; pull_request.go
+				// tells teams and users apart by the presence of that slash.
+				owners = append(owners, strings.TrimPrefix(owner, "@"))
+			}
+			return owners
+		}
+	}
+
+	return nil
+}
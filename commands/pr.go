@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/github/hub/utils"
+)
+
+var cmdPr = &Command{
+	Run: pr,
+	Usage: `
+pr ready [--undo] <NUMBER>
+pr update [<NUMBER>] [--rebase]
+`,
+	Long: `Manage the lifecycle of an existing GitHub pull request.
+
+## Commands:
+
+	* 'ready':
+		Mark <NUMBER> as ready for review. With '--undo', convert it back to a
+		draft instead. See hub-pull-request(1) for the '--ready' and
+		'--convert-to-draft' flags, which perform the same transition at
+		creation time.
+
+	* 'update':
+		Bring <NUMBER>'s branch up to date with its base, using GitHub's
+		update-branch API. <NUMBER> defaults to the pull request associated
+		with the current branch. If the API call fails, the branch is updated
+		locally instead, which requires it to be checked out: the base is
+		fetched from "origin" and merged in, or with '--rebase', the branch is
+		rebased onto it with 'git rebase --autostash'; the result is then
+		pushed with '--force-with-lease'.
+
+## See also:
+
+hub-pull-request(1)
+`,
+}
+
+func init() {
+	CmdRunner.Use(cmdPr)
+}
+
+func pr(cmd *Command, args *Args) {
+	if args.ParamsSize() == 0 {
+		utils.Check(fmt.Errorf("Usage: hub pr <ready|update> ..."))
+	}
+
+	subCommand := args.GetParam(0)
+	args.RemoveParam(0)
+
+	switch subCommand {
+	case "ready":
+		prReady(args)
+	case "update":
+		prUpdate(args)
+	default:
+		utils.Check(fmt.Errorf("error: unknown pr command '%s'", subCommand))
+	}
+}
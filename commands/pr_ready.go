@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/github/hub/github"
+	"github.com/github/hub/utils"
+)
+
+// prReady implements `hub pr ready [--undo] <NUMBER>`.
+func prReady(args *Args) {
+	if args.ParamsSize() == 0 {
+		utils.Check(fmt.Errorf("Usage: hub pr ready [--undo] <NUMBER>"))
+	}
+
+	number, err := strconv.Atoi(args.GetParam(0))
+	if err != nil {
+		utils.Check(fmt.Errorf("Invalid pull request number: %s", args.GetParam(0)))
+	}
+
+	draft := args.Flag.Bool("--undo")
+
+	localRepo, err := github.LocalRepo()
+	utils.Check(err)
+
+	baseProject, err := localRepo.MainProject()
+	utils.Check(err)
+
+	host, err := github.CurrentConfig().PromptForHost(baseProject.Host)
+	if err != nil {
+		utils.Check(github.FormatError("converting pull request", err))
+	}
+	client := github.NewClientWithHost(host)
+
+	args.NoForward()
+	if args.Noop {
+		if draft {
+			args.Before(fmt.Sprintf("Would convert pull request #%d to a draft", number), "")
+		} else {
+			args.Before(fmt.Sprintf("Would mark pull request #%d as ready for review", number), "")
+		}
+		return
+	}
+
+	err = client.SetPullRequestDraft(baseProject, number, draft)
+	utils.Check(err)
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/github/hub/git"
+	"github.com/github/hub/github"
+	"github.com/github/hub/ui"
+	"github.com/github/hub/utils"
+)
+
+// prUpdate implements `hub pr update [<NUMBER>] [--rebase]`.
+func prUpdate(args *Args) {
+	localRepo, err := github.LocalRepo()
+	utils.Check(err)
+
+	baseProject, err := localRepo.MainProject()
+	utils.Check(err)
+
+	host, err := github.CurrentConfig().PromptForHost(baseProject.Host)
+	if err != nil {
+		utils.Check(github.FormatError("updating pull request", err))
+	}
+	client := github.NewClientWithHost(host)
+
+	var number int
+	if args.ParamsSize() > 0 {
+		number, err = strconv.Atoi(args.GetParam(0))
+		if err != nil {
+			utils.Check(fmt.Errorf("Invalid pull request number: %s", args.GetParam(0)))
+		}
+	} else {
+		currentBranch, err := localRepo.CurrentBranch()
+		utils.Check(err)
+
+		_, headProject, err := localRepo.RemoteBranchAndProject(host.User, false)
+		utils.Check(err)
+		headOwner := baseProject.Owner
+		if headProject != nil {
+			headOwner = headProject.Owner
+		}
+
+		found, err := client.PullRequestForBranch(baseProject, headOwner, currentBranch.ShortName())
+		utils.Check(err)
+		if found == nil {
+			utils.Check(fmt.Errorf("no open pull request found for the current branch; specify <NUMBER>"))
+		}
+		number = found.Number
+	}
+
+	pr, err := client.PullRequest(baseProject, strconv.Itoa(number))
+	utils.Check(err)
+
+	flagRebase := args.Flag.Bool("--rebase")
+
+	args.NoForward()
+	if args.Noop {
+		args.Before(fmt.Sprintf("Would update pull request #%d's branch", number), "")
+		return
+	}
+
+	err = client.UpdatePullRequestBranch(baseProject, number, pr.Head.Sha)
+	if err == nil {
+		ui.Println(fmt.Sprintf("Updated #%d's branch from %s", number, pr.Base.Ref))
+		return
+	}
+
+	ui.Errorln(fmt.Sprintf("warning: remote update failed (%s); falling back to a local update", err))
+
+	headBranch := pr.Head.Ref
+	baseBranch := pr.Base.Ref
+
+	currentBranch, err := localRepo.CurrentBranch()
+	utils.Check(err)
+	if currentBranch.ShortName() != headBranch {
+		utils.Check(fmt.Errorf("check out %s locally before updating it without API access", headBranch))
+	}
+
+	err = git.Spawn("fetch", "origin", baseBranch)
+	utils.Check(err)
+
+	if flagRebase {
+		err = git.Spawn("rebase", "--autostash", fmt.Sprintf("origin/%s", baseBranch))
+	} else {
+		err = git.Spawn("merge", fmt.Sprintf("origin/%s", baseBranch))
+	}
+	utils.Check(err)
+
+	err = git.Spawn("push", "--force-with-lease", "origin", fmt.Sprintf("HEAD:%s", headBranch))
+	utils.Check(err)
+}
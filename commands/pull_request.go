@@ -10,13 +10,14 @@ import (
 
 	"github.com/github/hub/git"
 	"github.com/github/hub/github"
+	"github.com/github/hub/ui"
 	"github.com/github/hub/utils"
 )
 
 var cmdPullRequest = &Command{
 	Run: pullRequest,
 	Usage: `
-pull-request [-focp] [-b <BASE>] [-h <HEAD>] [-r <REVIEWERS> ] [-a <ASSIGNEES>] [-M <MILESTONE>] [-l <LABELS>] [--draft]
+pull-request [-focp] [-b <BASE>] [-h <HEAD>] [-r <REVIEWERS> ] [-a <ASSIGNEES>] [-M <MILESTONE>] [-l <LABELS>] [--draft] [--ready] [--convert-to-draft] [--auto-merge[=<METHOD>]] [--check-mergeable]
 pull-request -m <MESSAGE> [--edit]
 pull-request -F <FILE> [--edit]
 pull-request -i <ISSUE>
@@ -75,6 +76,8 @@ pull-request -i <ISSUE>
 
 	-r, --reviewer <USERS>
 		A comma-separated list of GitHub handles to request a review from.
+		If omitted and the base branch's protection rules require code owner
+		reviews, the repository's CODEOWNERS file is consulted instead.
 
 	-a, --assign <USERS>
 		A comma-separated list of GitHub handles to assign to this pull request.
@@ -90,6 +93,27 @@ pull-request -i <ISSUE>
 	-d, --draft
 		Create the pull request as a draft.
 
+	--ready
+		Immediately mark the created pull request as ready for review. Useful
+		together with a repository or organization default that opens pull
+		requests as drafts.
+
+	--convert-to-draft
+		Immediately convert the created pull request to a draft.
+
+	--auto-merge[=<METHOD>]
+		Enable auto-merge on the created pull request, so that GitHub merges it
+		automatically once all required status checks and reviews pass. <METHOD>
+		is one of "merge", "squash", or "rebase" (default: "merge").
+
+	--check-mergeable
+		Before creating the pull request, simulate merging <HEAD> into <BASE>
+		locally and abort if it would conflict. Reports the conflicting paths,
+		the ahead/behind commit counts, and a summary of changed files. Enabled
+		by default when standard input is a TTY and <HEAD> already has an
+		upstream (i.e. has been pushed before); if the check itself can't run,
+		a warning is printed instead of aborting pull request creation.
+
 ## Examples:
 		$ hub pull-request
 		[ opens a text editor for writing title and message ]
@@ -155,6 +179,14 @@ func pullRequest(cmd *Command, args *Args) {
 		base = localRepo.DefaultBranch(baseRemote).ShortName()
 	}
 
+	var branchProtection *github.BranchProtection
+	if base != "" {
+		branchProtection, err = client.BranchProtection(baseProject, base)
+		if err != nil {
+			ui.Errorln(fmt.Sprintf("warning: couldn't check branch protection for %s: %s", base, err))
+		}
+	}
+
 	if head == "" && trackedBranch != nil {
 		if !trackedBranch.IsRemote() {
 			// the current branch tracking another branch
@@ -218,12 +250,48 @@ func pullRequest(cmd *Command, args *Args) {
 	if flagPullRequestPush && remote == nil {
 		utils.Check(fmt.Errorf("Can't find remote for %s", head))
 	}
+	if flagPullRequestPush && branchProtection != nil && head == base && baseProject.SameAs(headProject) {
+		utils.Check(fmt.Errorf("Aborted: refusing to push directly to protected branch %s", base))
+	}
+
+	if branchProtection != nil && branchProtection.RequireSignedCommits && trackedBranch != nil {
+		unsigned, err := git.UnsignedCommits(baseTracking, headTracking)
+		if err != nil {
+			ui.Errorln(fmt.Sprintf("warning: couldn't verify commit signatures: %s", err))
+		} else if len(unsigned) > 0 {
+			ui.Errorln(fmt.Sprintf("warning: %s requires signed commits, but %d commit(s) on %s aren't signed: %s", base, len(unsigned), headTracking, strings.Join(unsigned, ", ")))
+		}
+	}
 
 	messageBuilder.AddCommentedSection(fmt.Sprintf(`Requesting a pull to %s from %s
 
 Write a message for this pull request. The first block
 of text is the title and the rest is the description.`, fullBase, fullHead))
 
+	flagCheckMergeable := args.Flag.Bool("--check-mergeable")
+	if !args.Flag.HasReceived("--check-mergeable") {
+		// headTracking only resolves to a real local ref once the branch has
+		// an upstream, i.e. has already been pushed at least once. Before
+		// that, `git merge-base` has nothing to compare against, so don't
+		// turn this on by default and fail PR creation over it.
+		flagCheckMergeable = trackedBranch != nil && ui.IsTerminal(os.Stdin)
+	}
+
+	if flagCheckMergeable {
+		mergeResult, err := git.TestMerge(baseTracking, headTracking)
+		if err != nil {
+			ui.Errorln(fmt.Sprintf("warning: couldn't check mergeability of %s into %s: %s", headTracking, baseTracking, err))
+		} else if !mergeResult.Clean() {
+			err = fmt.Errorf("Aborted: %d conflicts in %s", len(mergeResult.Conflicts), strings.Join(mergeResult.Conflicts, ", "))
+			err = fmt.Errorf("%s\n(rebase %s onto %s and resolve the conflicts, then try again)", err, headTracking, baseTracking)
+			utils.Check(err)
+		} else {
+			messageBuilder.AddCommentedSection(fmt.Sprintf("\n%s is %d commit(s) ahead, %d commit(s) behind %s.\nChanged files (%d):\n\n%s",
+				headTracking, mergeResult.Ahead, mergeResult.Behind, baseTracking,
+				len(mergeResult.ChangedFiles), strings.Join(mergeResult.ChangedFiles, "\n")))
+		}
+	}
+
 	flagPullRequestMessage := args.Flag.AllValues("--message")
 	flagPullRequestEdit := args.Flag.Bool("--edit")
 	flagPullRequestIssue := args.Flag.Value("--issue")
@@ -314,6 +382,23 @@ of text is the title and the rest is the description.`, fullBase, fullHead))
 
 	draft := args.Flag.Bool("--draft")
 
+	if args.Flag.Bool("--ready") && args.Flag.Bool("--convert-to-draft") {
+		utils.Check(fmt.Errorf("Can't use `--ready` and `--convert-to-draft` together"))
+	}
+
+	flagPullRequestAutoMerge := args.Flag.HasReceived("--auto-merge")
+	autoMergeMethod := strings.ToLower(args.Flag.Value("--auto-merge"))
+	if flagPullRequestAutoMerge {
+		if autoMergeMethod == "" {
+			autoMergeMethod = "merge"
+		}
+		switch autoMergeMethod {
+		case "merge", "squash", "rebase":
+		default:
+			utils.Check(fmt.Errorf("Unsupported --auto-merge method: %s", autoMergeMethod))
+		}
+	}
+
 	var pullRequestURL string
 	if args.Noop {
 		args.Before(fmt.Sprintf("Would request a pull request to %s from %s", fullBase, fullHead), "")
@@ -377,6 +462,25 @@ of text is the title and the rest is the description.`, fullBase, fullHead))
 
 		pullRequestURL = pr.HtmlUrl
 
+		// The pull request already exists at this point, so a failure in one
+		// of these follow-up steps shouldn't be reported as a failure to
+		// create it (and shouldn't exit non-zero); warn and keep going.
+		if flagPullRequestAutoMerge {
+			if err = client.EnableAutoMerge(pr, autoMergeMethod, title, body); err != nil {
+				ui.Errorln(fmt.Sprintf("warning: created %s, but couldn't enable auto-merge: %s", pullRequestURL, err))
+			}
+		}
+
+		if args.Flag.Bool("--ready") {
+			if err = client.SetPullRequestDraft(baseProject, pr.Number, false); err != nil {
+				ui.Errorln(fmt.Sprintf("warning: created %s, but couldn't mark it ready for review: %s", pullRequestURL, err))
+			}
+		} else if args.Flag.Bool("--convert-to-draft") {
+			if err = client.SetPullRequestDraft(baseProject, pr.Number, true); err != nil {
+				ui.Errorln(fmt.Sprintf("warning: created %s, but couldn't convert it to a draft: %s", pullRequestURL, err))
+			}
+		}
+
 		params = map[string]interface{}{}
 		flagPullRequestLabels := commaSeparated(args.Flag.AllValues("--labels"))
 		if len(flagPullRequestLabels) > 0 {
@@ -396,6 +500,11 @@ of text is the title and the rest is the description.`, fullBase, fullHead))
 		}
 
 		flagPullRequestReviewers := commaSeparated(args.Flag.AllValues("--reviewer"))
+		if len(flagPullRequestReviewers) == 0 && branchProtection != nil && branchProtection.RequireCodeOwnerReviews {
+			if workdir, _ := git.WorkdirName(); workdir != "" {
+				flagPullRequestReviewers = codeownersReviewers(workdir)
+			}
+		}
 		if len(flagPullRequestReviewers) > 0 {
 			userReviewers := []string{}
 			teamReviewers := []string{}
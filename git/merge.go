@@ -0,0 +1,149 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MergeResult summarizes the outcome of a local, non-destructive merge
+// simulation between two refs.
+type MergeResult struct {
+	Conflicts    []string
+	ChangedFiles []string
+	Ahead        int
+	Behind       int
+}
+
+// Clean reports whether the simulated merge produced no conflicts.
+func (r *MergeResult) Clean() bool {
+	return len(r.Conflicts) == 0
+}
+
+// TestMerge simulates merging head into base without touching the working
+// tree or any refs, using `git merge-tree`. It also reports how far base and
+// head have diverged and which files the merge would touch.
+func TestMerge(base, head string) (*MergeResult, error) {
+	mergeBaseOutput, err := GitCommand("merge-base", base, head).Output()
+	if err != nil {
+		return nil, fmt.Errorf("can't find merge base between %s and %s", base, head)
+	}
+	mergeBase := strings.TrimSpace(mergeBaseOutput)
+
+	treeOutput, err := GitCommand("merge-tree", mergeBase, base, head).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MergeResult{
+		Conflicts: parseConflictedPaths(treeOutput),
+	}
+
+	if changed, err := GitCommand("diff", "--name-only", fmt.Sprintf("%s...%s", base, head)).Output(); err == nil {
+		result.ChangedFiles = splitNonEmpty(changed)
+	}
+
+	if counts, err := GitCommand("rev-list", "--left-right", "--count", fmt.Sprintf("%s...%s", base, head)).Output(); err == nil {
+		fields := strings.Fields(strings.TrimSpace(counts))
+		if len(fields) == 2 {
+			result.Behind, _ = strconv.Atoi(fields[0])
+			result.Ahead, _ = strconv.Atoi(fields[1])
+		}
+	}
+
+	return result, nil
+}
+
+// removalSections are the `git merge-tree` headers for a path deleted on one
+// side. They're printed both for a clean deletion (the other side left the
+// path as it was in the merge base) and for a modify/delete conflict.
+var removalSections = map[string]bool{
+	"removed in local":  true,
+	"removed in remote": true,
+}
+
+// parseConflictedPaths scans `git merge-tree`'s output for paths that
+// actually conflict. The "added in both" / "changed in both" headers alone
+// aren't enough: they're printed for every path touched on both sides, even
+// when the auto-merge resolves cleanly, so a path in one of those sections
+// only really conflicts when it also contains "<<<<<<<" markers. A path in a
+// "removed in local"/"removed in remote" section conflicts when the side that
+// kept it changed it (its blob differs from the merge base's): a
+// modify/delete, for which there's no auto-resolution to offer.
+//
+// Known gap: this doesn't detect rename/rename or rename/delete conflicts,
+// which `git merge-tree`'s plumbing output doesn't label as clearly as the
+// sections above; those will still be reported as Clean() here and caught
+// by the server when the pull request is opened.
+func parseConflictedPaths(mergeTreeOutput string) []string {
+	paths := []string{}
+	seen := map[string]bool{}
+
+	var currentPath string
+	var conflicted, removal bool
+	stageSHAs := map[string]string{}
+	inSection := false
+
+	flush := func() {
+		if removal {
+			// Only one of "our"/"their" is listed in a removal section.
+			conflicted = stageSHAs["our"]+stageSHAs["their"] != stageSHAs["base"]
+		}
+		if conflicted && currentPath != "" && !seen[currentPath] {
+			seen[currentPath] = true
+			paths = append(paths, currentPath)
+		}
+		currentPath = ""
+		conflicted = false
+		removal = false
+		stageSHAs = map[string]string{}
+		inSection = false
+	}
+
+	for _, line := range strings.Split(mergeTreeOutput, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "added in both" || trimmed == "changed in both" {
+			flush()
+			inSection = true
+			continue
+		}
+
+		if removalSections[trimmed] {
+			flush()
+			inSection = true
+			removal = true
+			continue
+		}
+
+		if !inSection {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "our ") || strings.HasPrefix(trimmed, "their ") || strings.HasPrefix(trimmed, "base ") {
+			// "<stage> <mode> <sha> <path>"
+			if fields := strings.Fields(trimmed); len(fields) >= 4 && (currentPath == "" || currentPath == fields[len(fields)-1]) {
+				currentPath = fields[len(fields)-1]
+				stageSHAs[fields[0]] = fields[2]
+				continue
+			}
+		}
+
+		if strings.Contains(line, "<<<<<<<") {
+			conflicted = true
+		}
+	}
+	flush()
+
+	return paths
+}
+
+func splitNonEmpty(s string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(s), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,32 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsignedCommits returns the abbreviated SHAs of commits reachable from head
+// but not base whose GPG/SSH signature is missing or doesn't verify, so
+// callers can warn when a branch requires signed commits.
+func UnsignedCommits(base, head string) ([]string, error) {
+	output, err := GitCommand("log", "--pretty=%h %G?", fmt.Sprintf("%s..%s", base, head)).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	unsigned := []string{}
+	for _, line := range splitNonEmpty(output) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		sha, status := fields[0], fields[1]
+		// "G" (good) and "U" (good but untrusted) both mean a signature is
+		// present and verifies; anything else means no usable signature.
+		if status != "G" && status != "U" {
+			unsigned = append(unsigned, sha)
+		}
+	}
+
+	return unsigned, nil
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnableAutoMerge schedules pr to be merged automatically via method ("merge",
+// "squash" or "rebase") once its required status checks and reviews are
+// satisfied. commitTitle and commitMessage override the generated merge
+// commit's message and may be left empty to accept GitHub's defaults.
+func (client *Client) EnableAutoMerge(pr *PullRequest, method, commitTitle, commitMessage string) error {
+	if pr.NodeID == "" {
+		return fmt.Errorf("can't enable auto-merge: pull request #%d has no GraphQL node id", pr.Number)
+	}
+
+	mergeMethod := strings.ToUpper(method)
+	if mergeMethod == "" {
+		mergeMethod = "MERGE"
+	}
+
+	variables := map[string]interface{}{
+		"pullRequestId": pr.NodeID,
+		"mergeMethod":   mergeMethod,
+	}
+	if commitTitle != "" {
+		variables["commitHeadline"] = commitTitle
+	}
+	if commitMessage != "" {
+		variables["commitBody"] = commitMessage
+	}
+
+	var result struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				AutoMergeRequest struct {
+					EnabledAt string `json:"enabledAt"`
+				} `json:"autoMergeRequest"`
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+
+	err := client.graphQL(`
+mutation($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod!, $commitHeadline: String, $commitBody: String) {
+  enablePullRequestAutoMerge(input: {
+    pullRequestId: $pullRequestId,
+    mergeMethod: $mergeMethod,
+    commitHeadline: $commitHeadline,
+    commitBody: $commitBody
+  }) {
+    pullRequest {
+      autoMergeRequest {
+        enabledAt
+      }
+    }
+  }
+}`, variables, &result)
+
+	if err != nil && strings.Contains(err.Error(), "Auto-merge is not allowed") {
+		return fmt.Errorf("auto-merge is not enabled for this repository; ask a repo admin to turn it on under repository settings")
+	}
+
+	return err
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"fmt"
+)
+
+// BranchProtection describes the subset of a branch's protection rules that
+// matter for deciding whether it's safe to push to, or to open a pull
+// request against, without the server rejecting the result.
+type BranchProtection struct {
+	RequiredApprovingReviews int
+	RequireCodeOwnerReviews  bool
+	RequiredStatusChecks     []string
+	RequireSignedCommits     bool
+	RestrictedToUsers        []string
+	RestrictedToTeams        []string
+}
+
+// BranchProtection fetches the protection rules configured for branch in
+// project. A nil result with no error means the branch isn't protected.
+func (client *Client) BranchProtection(project *Project, branch string) (*BranchProtection, error) {
+	api, err := client.simpleApi()
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/branches/%s/protection", project.Owner, project.Name, branch)
+	response, err := api.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == 404 {
+		return nil, nil
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("can't check branch protection for %s: unexpected HTTP %d", branch, response.StatusCode)
+	}
+
+	var record struct {
+		RequiredPullRequestReviews *struct {
+			RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+			RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		} `json:"required_pull_request_reviews"`
+		RequiredStatusChecks *struct {
+			Contexts []string `json:"contexts"`
+		} `json:"required_status_checks"`
+		RequiredSignatures *struct {
+			Enabled bool `json:"enabled"`
+		} `json:"required_signatures"`
+		Restrictions *struct {
+			Users []struct {
+				Login string `json:"login"`
+			} `json:"users"`
+			Teams []struct {
+				Slug string `json:"slug"`
+			} `json:"teams"`
+		} `json:"restrictions"`
+	}
+
+	if err := response.Unmarshal(&record); err != nil {
+		return nil, err
+	}
+
+	protection := &BranchProtection{}
+	if record.RequiredPullRequestReviews != nil {
+		protection.RequiredApprovingReviews = record.RequiredPullRequestReviews.RequiredApprovingReviewCount
+		protection.RequireCodeOwnerReviews = record.RequiredPullRequestReviews.RequireCodeOwnerReviews
+	}
+	if record.RequiredStatusChecks != nil {
+		protection.RequiredStatusChecks = record.RequiredStatusChecks.Contexts
+	}
+	if record.RequiredSignatures != nil {
+		protection.RequireSignedCommits = record.RequiredSignatures.Enabled
+	}
+	if record.Restrictions != nil {
+		for _, u := range record.Restrictions.Users {
+			protection.RestrictedToUsers = append(protection.RestrictedToUsers, u.Login)
+		}
+		for _, t := range record.Restrictions.Teams {
+			protection.RestrictedToTeams = append(protection.RestrictedToTeams, t.Slug)
+		}
+	}
+
+	return protection, nil
+}
@@ -0,0 +1,41 @@
+package github
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SetPullRequestDraft flips the draft status of an existing pull request
+// using the markPullRequestReadyForReview / convertPullRequestToDraft
+// GraphQL mutations, since the REST API exposes no endpoint for this
+// transition once a pull request has been created.
+func (client *Client) SetPullRequestDraft(project *Project, number int, draft bool) error {
+	pr, err := client.PullRequest(project, strconv.Itoa(number))
+	if err != nil {
+		return err
+	}
+	if pr.NodeID == "" {
+		return fmt.Errorf("can't change draft status: pull request #%d has no GraphQL node id", number)
+	}
+
+	variables := map[string]interface{}{
+		"pullRequestId": pr.NodeID,
+	}
+
+	mutation := `
+mutation($pullRequestId: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { id }
+  }
+}`
+	if draft {
+		mutation = `
+mutation($pullRequestId: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { id }
+  }
+}`
+	}
+
+	return client.graphQL(mutation, variables, nil)
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// graphQL executes query against the current host's GraphQL API, decoding
+// the "data" key of the response into data. Variables may be nil if the
+// query takes none.
+//
+// The GraphQL endpoint isn't reachable as a path relative to the REST API
+// root: on github.com that root is "api.github.com/" and "graphql" happens
+// to land in the right place, but on GitHub Enterprise the REST root is
+// "<host>/api/v3/" while GraphQL lives at "<host>/api/graphql". So Enterprise
+// hosts get an explicit absolute URL instead of a path relative to the REST
+// client.
+func (client *Client) graphQL(query string, variables map[string]interface{}, data interface{}) error {
+	api, err := client.simpleApi()
+	if err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{
+		"query": query,
+	}
+	if len(variables) > 0 {
+		params["variables"] = variables
+	}
+
+	endpoint := "graphql"
+	if client.Host != nil && !strings.EqualFold(client.Host.Host, GitHubHost) {
+		endpoint = fmt.Sprintf("https://%s/api/graphql", client.Host.Host)
+	}
+
+	response, err := api.PostJSON(endpoint, params)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	if err := response.Unmarshal(&result); err != nil {
+		return err
+	}
+
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%s", result.Errors[0].Message)
+	}
+
+	if data != nil && len(result.Data) > 0 {
+		return json.Unmarshal(result.Data, data)
+	}
+
+	return nil
+}
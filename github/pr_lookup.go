@@ -0,0 +1,31 @@
+package github
+
+import "fmt"
+
+// PullRequestForBranch returns the open pull request against project whose
+// head is branch, owned by headOwner. headOwner lets this find pull requests
+// opened from a fork, where the head owner differs from project's owner.
+func (client *Client) PullRequestForBranch(project *Project, headOwner, branch string) (*PullRequest, error) {
+	api, err := client.simpleApi()
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/pulls?head=%s:%s&state=open", project.Owner, project.Name, headOwner, branch)
+	response, err := api.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var pulls []PullRequest
+	if err := response.Unmarshal(&pulls); err != nil {
+		return nil, err
+	}
+
+	if len(pulls) == 0 {
+		return nil, nil
+	}
+
+	return &pulls[0], nil
+}
@@ -0,0 +1,32 @@
+package github
+
+import "fmt"
+
+// UpdatePullRequestBranch brings pull request number's head branch up to
+// date with its base via GitHub's update-branch API. expectedSHA guards
+// against racing with commits landed on the head branch after it was last
+// observed; pass "" to skip the check.
+func (client *Client) UpdatePullRequestBranch(project *Project, number int, expectedSHA string) error {
+	api, err := client.simpleApi()
+	if err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{}
+	if expectedSHA != "" {
+		params["expected_head_sha"] = expectedSHA
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d/update-branch", project.Owner, project.Name, number)
+	response, err := api.PutJSON(path, params)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("can't update branch of pull request #%d: unexpected HTTP %d", number, response.StatusCode)
+	}
+
+	return nil
+}